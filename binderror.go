@@ -0,0 +1,57 @@
+package httppayload
+
+import (
+	"errors"
+	"fmt"
+
+	ende "github.com/canpacis/http-payload/internal/structende"
+)
+
+// FieldError reports a single struct field that failed to bind, either because it was
+// required but missing, or because its value could not be cast to the field's type.
+type FieldError struct {
+	Source string // the tag key the field was read from, e.g. "query" or "header"
+	Key    string // the tag value, i.e. the name the value is looked up under
+	Field  string // the struct field name
+	Err    error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("httppayload: %s %q: %v", e.Source, e.Key, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FieldErrors aggregates every `FieldError` a single scan produced, so callers can report all
+// of them at once instead of failing on the first one.
+type FieldErrors []*FieldError
+
+func (e FieldErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+
+	msg := fmt.Sprintf("httppayload: %d fields failed binding:", len(e))
+	for _, fe := range e {
+		msg += "\n  " + fe.Error()
+	}
+	return msg
+}
+
+// bindError converts an internal/structende.ValidationErrors into the public FieldErrors, so
+// callers outside this module can `errors.As` into it to read Source/Key/Field. Any other
+// error, including nil, passes through unchanged.
+func bindError(err error) error {
+	var verrs ende.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return err
+	}
+
+	errs := make(FieldErrors, len(verrs))
+	for i, fe := range verrs {
+		errs[i] = &FieldError{Source: fe.Source, Key: fe.Key, Field: fe.Field, Err: fe.Err}
+	}
+	return errs
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"text/template"
+)
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by httppayload-gen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"net/http"
+
+	payload "github.com/canpacis/http-payload"
+{{- if .NeedsValidator }}
+	"github.com/canpacis/http-payload/validator"
+{{- end }}
+)
+
+{{ if .NeedsValidator }}
+// requestValidator enforces the validate:"..." tags on generated request bodies, since body
+// properties are decoded with plain encoding/json and don't go through NewDecoder's own
+// required-field enforcement.
+var requestValidator = validator.New()
+{{ end }}
+
+{{ range .Operations }}
+// {{ .ID }}Request is the bound request for "{{ .Method }} {{ .Path }}".
+type {{ .ID }}Request struct {
+{{- range .Fields }}
+	{{ .Name }} {{ .Type }} ` + "`" + `{{ .Tag }}` + "`" + `
+{{- end }}
+}
+
+// {{ .ID }}Response is the response body for "{{ .Method }} {{ .Path }}".
+type {{ .ID }}Response struct {
+	Body any
+}
+{{ end }}
+
+// ServerInterface is implemented by handlers of the operations in this document.
+type ServerInterface interface {
+{{- range .Operations }}
+	{{ .ID }}(req *{{ .ID }}Request) (*{{ .ID }}Response, error)
+{{- end }}
+}
+
+{{ range .Operations }}
+// bind{{ .ID }} assembles the scanner/printer pipeline for "{{ .Method }} {{ .Path }}",
+// binds the request, calls impl.{{ .ID }}, and negotiates the response encoding.
+func bind{{ .ID }}(impl ServerInterface) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req := &{{ .ID }}Request{}
+
+		scanner := payload.NewPipeScanner(
+{{- if .HasBody }}
+			payload.NewNegotiateScannerFromRequest(r),
+{{- end }}
+			payload.NewQueryScanner(r.URL.Query()),
+			payload.NewHeaderScanner(&r.Header),
+			payload.NewPathScanner(r),
+			payload.NewCookieScanner(r.Cookies()),
+		)
+{{- if .HasBody }}
+		if err := payload.NewValidatingScanner(scanner, requestValidator).Scan(req); err != nil {
+{{- else }}
+		if err := scanner.Scan(req); err != nil {
+{{- end }}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		res, err := impl.{{ .ID }}(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		printer := payload.NewNegotiatePrinterFromRequest(w, r)
+		if err := printer.Print(res.Body); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+{{ end }}
+
+// RegisterHandlers mounts every generated operation of impl onto mux.
+func RegisterHandlers(mux *http.ServeMux, impl ServerInterface) {
+{{- range .Operations }}
+	mux.HandleFunc("{{ .Method }} {{ .Path }}", bind{{ .ID }}(impl))
+{{- end }}
+}
+`))
+
+type fileData struct {
+	Package        string
+	Operations     []operation
+	NeedsValidator bool
+}
+
+func generate(pkg string, ops []operation) ([]byte, error) {
+	needsValidator := false
+	for _, op := range ops {
+		if op.HasBody {
+			needsValidator = true
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	data := fileData{Package: pkg, Operations: ops, NeedsValidator: needsValidator}
+	if err := fileTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
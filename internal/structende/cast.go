@@ -0,0 +1,102 @@
+package structende
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// StringUnmarshaler is implemented by types that know how to populate themselves from a
+// single string value, such as `Role` in a `query:"role"` field.
+type StringUnmarshaler interface {
+	UnmarshalString(string) error
+}
+
+// DefaultCast casts a raw value coming out of a `Getter` into the given field type. It is
+// the casting behaviour used by every built-in scanner unless the `Getter` implements
+// `Caster` itself.
+func DefaultCast(from any, to reflect.Type) (any, error) {
+	if from != nil && reflect.TypeOf(from).AssignableTo(to) {
+		return from, nil
+	}
+
+	s, ok := from.(string)
+	if !ok {
+		return nil, fmt.Errorf("structende: cannot cast %T to %s", from, to)
+	}
+
+	if to.Kind() == reflect.Slice {
+		return castSlice(s, to)
+	}
+
+	return castScalar(s, to)
+}
+
+func castSlice(s string, to reflect.Type) (any, error) {
+	parts := strings.Split(s, ",")
+	elem := to.Elem()
+
+	slice := reflect.MakeSlice(to, len(parts), len(parts))
+	for i, part := range parts {
+		v, err := castScalar(part, elem)
+		if err != nil {
+			return nil, err
+		}
+		slice.Index(i).Set(reflect.ValueOf(v))
+	}
+
+	return slice.Interface(), nil
+}
+
+func castScalar(s string, to reflect.Type) (any, error) {
+	switch to.Kind() {
+	case reflect.String:
+		return s, nil
+	case reflect.Bool:
+		return strconv.ParseBool(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(v).Convert(to).Interface(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(v).Convert(to).Interface(), nil
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(v).Convert(to).Interface(), nil
+	case reflect.Struct, reflect.Ptr:
+		return castUnmarshaler(s, to)
+	default:
+		return nil, fmt.Errorf("structende: unsupported cast target %s", to)
+	}
+}
+
+func castUnmarshaler(s string, to reflect.Type) (any, error) {
+	ptrType := to
+	if to.Kind() != reflect.Ptr {
+		ptrType = reflect.PtrTo(to)
+	}
+
+	ptr := reflect.New(ptrType.Elem())
+	u, ok := ptr.Interface().(StringUnmarshaler)
+	if !ok {
+		return nil, fmt.Errorf("structende: %s does not implement structende.StringUnmarshaler", to)
+	}
+	if err := u.UnmarshalString(s); err != nil {
+		return nil, err
+	}
+
+	if to.Kind() == reflect.Ptr {
+		return ptr.Interface(), nil
+	}
+	return ptr.Elem().Interface(), nil
+}
@@ -0,0 +1,107 @@
+package structende
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Getter is implemented by a scanner's source so the decoder can look up a raw value for a
+// given tag key, e.g. a header name or a query parameter name.
+type Getter interface {
+	Get(key string) any
+}
+
+// Caster lets a source customize how raw values are cast into destination field types.
+// Sources that don't implement it fall back to `DefaultCast`.
+type Caster interface {
+	Cast(from any, to reflect.Type) (any, error)
+}
+
+// Decoder walks a struct's fields looking for ones tagged with `tag`, pulling their raw
+// value out of a `Getter` and casting it onto the field.
+type Decoder struct {
+	src Getter
+	tag string
+}
+
+func NewDecoder(src Getter, tag string) *Decoder {
+	return &Decoder{src: src, tag: tag}
+}
+
+// Decode populates v, which must be a pointer to a struct, from the decoder's source.
+//
+// A field whose value is missing or empty is left untouched unless it carries a
+// `default:"..."` tag, in which case the default is cast in its place, or a
+// `required:"true"` tag, in which case its absence is recorded as a `FieldError`. A field
+// whose value fails to cast is recorded as a `FieldError` too. Decode keeps scanning after
+// either kind of failure so the returned `ValidationErrors` lists every offending field, not
+// just the first.
+//
+// The field layout for v's type is computed once per (type, tag) pair and cached, so repeat
+// calls for the same struct type skip straight to indexed `reflect.Value.Field` access
+// instead of re-walking the struct with reflection.
+func (d *Decoder) Decode(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("structende: Decode expects a pointer to a struct, got %T", v)
+	}
+	rv = rv.Elem()
+
+	plan := planFor(rv.Type(), d.tag)
+
+	var errs ValidationErrors
+
+	for _, fp := range plan.Fields {
+		raw := d.src.Get(fp.Key)
+		if isEmpty(raw) {
+			switch {
+			case fp.HasDefault:
+				raw = fp.Default
+			case fp.Required:
+				errs = append(errs, &FieldError{
+					Source: d.tag,
+					Key:    fp.Key,
+					Field:  rv.Type().Field(fp.Index).Name,
+					Err:    fmt.Errorf("required field is missing"),
+				})
+				continue
+			default:
+				continue
+			}
+		}
+
+		value, err := d.cast(raw, fp.Type)
+		if err != nil {
+			errs = append(errs, &FieldError{
+				Source: d.tag,
+				Key:    fp.Key,
+				Field:  rv.Type().Field(fp.Index).Name,
+				Err:    err,
+			})
+			continue
+		}
+
+		rv.Field(fp.Index).Set(reflect.ValueOf(value))
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func (d *Decoder) cast(from any, to reflect.Type) (any, error) {
+	if c, ok := d.src.(Caster); ok {
+		return c.Cast(from, to)
+	}
+	return DefaultCast(from, to)
+}
+
+func isEmpty(v any) bool {
+	if v == nil {
+		return true
+	}
+	s, ok := v.(string)
+	return ok && s == ""
+}
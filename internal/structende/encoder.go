@@ -0,0 +1,63 @@
+package structende
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Setter is implemented by a printer's destination so the encoder can hand it each tagged
+// field's value under its tag key, e.g. a header name or a cookie name.
+type Setter interface {
+	Set(key string, value any)
+}
+
+// FieldSetter lets a destination inspect the full `reflect.StructField` a value came from,
+// e.g. to read printer-specific tags like `cookie-path`. A destination that implements it is
+// preferred over `Setter`.
+type FieldSetter interface {
+	SetField(key string, value any, field reflect.StructField)
+}
+
+// Encoder walks a struct's fields looking for ones tagged with `tag`, handing their value to
+// a `Setter`.
+type Encoder struct {
+	dst Setter
+	tag string
+}
+
+func NewEncoder(dst Setter, tag string) *Encoder {
+	return &Encoder{dst: dst, tag: tag}
+}
+
+// Encode reads v, which must be a struct or a pointer to one, and hands every field tagged
+// with the encoder's tag to its destination.
+//
+// The field layout for v's type is computed once per (type, tag) pair and cached, so repeat
+// calls for the same struct type skip straight to indexed `reflect.Value.Field` access
+// instead of re-walking the struct with reflection.
+func (e *Encoder) Encode(v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("structende: Encode expects a struct or a pointer to one, got %T", v)
+	}
+
+	rt := rv.Type()
+	plan := planFor(rt, e.tag)
+
+	fs, hasFieldSetter := e.dst.(FieldSetter)
+
+	for _, fp := range plan.Fields {
+		value := rv.Field(fp.Index).Interface()
+
+		if hasFieldSetter {
+			fs.SetField(fp.Key, value, rt.Field(fp.Index))
+		} else {
+			e.dst.Set(fp.Key, value)
+		}
+	}
+
+	return nil
+}
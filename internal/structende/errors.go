@@ -0,0 +1,36 @@
+package structende
+
+import "fmt"
+
+// FieldError reports a single field that failed to decode, either because it was required
+// but missing, or because its value could not be cast to the field's type.
+type FieldError struct {
+	Source string // the tag key the field was read from, e.g. "query" or "header"
+	Key    string // the tag value, i.e. the name the value is looked up under
+	Field  string // the struct field name
+	Err    error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("structende: %s %q: %v", e.Source, e.Key, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors aggregates every `FieldError` a single `Decode` call produced, so callers
+// can report all of them at once instead of failing on the first one.
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+
+	msg := fmt.Sprintf("structende: %d fields failed validation:", len(e))
+	for _, fe := range e {
+		msg += "\n  " + fe.Error()
+	}
+	return msg
+}
@@ -0,0 +1,64 @@
+package structende
+
+import (
+	"reflect"
+	"sync"
+)
+
+// fieldPlan is the precomputed, per-field work a Decoder/Encoder would otherwise redo on
+// every call: which field index to use, the tag key it's bound to, and its default/required
+// metadata.
+type fieldPlan struct {
+	Index      int
+	Key        string
+	Type       reflect.Type
+	Default    string
+	HasDefault bool
+	Required   bool
+}
+
+// typePlan is the full precomputed plan for a struct type under a single tag.
+type typePlan struct {
+	Fields []fieldPlan
+}
+
+type planKey struct {
+	Type reflect.Type
+	Tag  string
+}
+
+// plans caches a typePlan per (reflect.Type, tag) pair so Decode/Encode only walk a struct's
+// fields with reflection once per type, instead of on every call.
+var plans sync.Map // map[planKey]*typePlan
+
+func planFor(rt reflect.Type, tag string) *typePlan {
+	key := planKey{Type: rt, Tag: tag}
+
+	if cached, ok := plans.Load(key); ok {
+		return cached.(*typePlan)
+	}
+
+	plan := buildPlan(rt, tag)
+	actual, _ := plans.LoadOrStore(key, plan)
+	return actual.(*typePlan)
+}
+
+func buildPlan(rt reflect.Type, tag string) *typePlan {
+	plan := &typePlan{}
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		key, ok := field.Tag.Lookup(tag)
+		if !ok || key == "-" {
+			continue
+		}
+
+		fp := fieldPlan{Index: i, Key: key, Type: field.Type}
+		fp.Default, fp.HasDefault = field.Tag.Lookup("default")
+		fp.Required = field.Tag.Get("required") == "true"
+
+		plan.Fields = append(plan.Fields, fp)
+	}
+
+	return plan
+}
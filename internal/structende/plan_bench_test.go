@@ -0,0 +1,47 @@
+package structende_test
+
+import (
+	"testing"
+
+	ende "github.com/canpacis/http-payload/internal/structende"
+)
+
+type BenchParams struct {
+	Name  string `query:"name"`
+	Page  uint32 `query:"page" default:"1"`
+	Done  bool   `query:"done"`
+	Email string `query:"email" required:"true"`
+}
+
+func BenchmarkDecode(b *testing.B) {
+	src := mapGetter{"name": "John", "page": "2", "done": "true", "email": "john@example.com"}
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		decoder := ende.NewDecoder(src, "query")
+		p := &BenchParams{}
+		for pb.Next() {
+			if err := decoder.Decode(p); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+type discardSetter struct{}
+
+func (discardSetter) Set(key string, value any) {}
+
+func BenchmarkEncode(b *testing.B) {
+	v := &BenchParams{Name: "John", Page: 2, Done: true, Email: "john@example.com"}
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		encoder := ende.NewEncoder(discardSetter{}, "query")
+		for pb.Next() {
+			if err := encoder.Encode(v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package structende_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	ende "github.com/canpacis/http-payload/internal/structende"
+	"github.com/stretchr/testify/assert"
+)
+
+type mapGetter map[string]any
+
+func (m mapGetter) Get(key string) any {
+	return m[key]
+}
+
+type Params struct {
+	Name  string `query:"name"`
+	Page  uint32 `query:"page" default:"1"`
+	Email string `query:"email" required:"true"`
+}
+
+func TestDecoderDefault(t *testing.T) {
+	assert := assert.New(t)
+	p := &Params{}
+
+	err := ende.NewDecoder(mapGetter{"name": "John", "email": "john@example.com"}, "query").Decode(p)
+	assert.NoError(err)
+	assert.Equal("John", p.Name)
+	assert.Equal(uint32(1), p.Page)
+}
+
+func TestDecoderRequired(t *testing.T) {
+	assert := assert.New(t)
+	p := &Params{}
+
+	err := ende.NewDecoder(mapGetter{"name": "John"}, "query").Decode(p)
+	assert.Error(err)
+
+	var verrs ende.ValidationErrors
+	assert.True(errors.As(err, &verrs))
+	assert.Len(verrs, 1)
+	assert.Equal("Email", verrs[0].Field)
+}
+
+func TestDecoderRequiredAndCastErrorsBothReported(t *testing.T) {
+	assert := assert.New(t)
+	p := &Params{}
+
+	// Email is missing (required) and Page fails to cast; neither should mask the other.
+	err := ende.NewDecoder(mapGetter{"page": "not-a-number"}, "query").Decode(p)
+	assert.Error(err)
+
+	var verrs ende.ValidationErrors
+	assert.True(errors.As(err, &verrs))
+	assert.Len(verrs, 2)
+
+	fields := []string{verrs[0].Field, verrs[1].Field}
+	assert.Contains(fields, "Email")
+	assert.Contains(fields, "Page")
+}
+
+type mapSetter map[string]any
+
+func (m mapSetter) Set(key string, value any) {
+	m[key] = value
+}
+
+func TestEncoder(t *testing.T) {
+	assert := assert.New(t)
+	dst := mapSetter{}
+
+	err := ende.NewEncoder(dst, "query").Encode(&Params{Name: "John", Page: 2})
+	assert.NoError(err)
+	assert.Equal("John", dst["name"])
+	assert.Equal(uint32(2), dst["page"])
+}
+
+func TestDefaultCastSlice(t *testing.T) {
+	assert := assert.New(t)
+
+	v, err := ende.DefaultCast("1,2,3", reflect.TypeOf([]int{}))
+	assert.NoError(err)
+	assert.Equal([]int{1, 2, 3}, v)
+}
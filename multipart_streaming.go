@@ -0,0 +1,245 @@
+package httppayload
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var readerType = reflect.TypeOf((*io.Reader)(nil)).Elem()
+
+// MultipartPartHandler is invoked once per file part a StreamingMultipartScanner reads, for
+// streaming consumption (e.g. writing straight through to storage) instead of buffering the
+// whole upload first.
+type MultipartPartHandler func(field string, header *multipart.FileHeader, r io.Reader) error
+
+// MultipartSizeError is returned when a part exceeds the size cap set by its field's
+// `multipart-max` tag.
+type MultipartSizeError struct {
+	Field string
+	Max   int64
+}
+
+func (e *MultipartSizeError) Error() string {
+	return fmt.Sprintf("httppayload: part %q exceeds its %d byte limit", e.Field, e.Max)
+}
+
+// MultipartTypeError is returned when a part's content type isn't listed in its field's
+// `multipart-accept` tag.
+type MultipartTypeError struct {
+	Field       string
+	ContentType string
+	Accept      []string
+}
+
+func (e *MultipartTypeError) Error() string {
+	return fmt.Sprintf("httppayload: part %q has content type %q, want one of %v", e.Field, e.ContentType, e.Accept)
+}
+
+// A scanner that reads a multipart body part by part via `multipart.Reader`, instead of
+// buffering the whole form to memory or disk first like MultipartScanner does. The handler,
+// when set, is handed each file part's reader while the part is still live, so it can stream
+// the content straight through (e.g. to storage) without it ever sitting fully in memory.
+// A part that also matches a `multipart:"..."` tagged `io.Reader` field is additionally
+// buffered so the field's reader stays valid after Scan returns, since `multipart.Reader`
+// discards a part's remaining bytes as soon as the next one is requested.
+type StreamingMultipartScanner struct {
+	r       *multipart.Reader
+	handler MultipartPartHandler
+}
+
+// Scans the multipart body onto v, which must be a pointer to a struct
+func (s *StreamingMultipartScanner) Scan(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("httppayload: Scan expects a pointer to a struct, got %T", v)
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	fields := map[string]int{}
+	for i := 0; i < rt.NumField(); i++ {
+		if key, ok := rt.Field(i).Tag.Lookup("multipart"); ok && key != "-" {
+			fields[key] = i
+		}
+	}
+
+	for {
+		part, err := s.r.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := part.FormName()
+		if part.FileName() == "" {
+			part.Close()
+			continue
+		}
+
+		index, hasField := fields[name]
+		var field reflect.StructField
+		if hasField {
+			field = rt.Field(index)
+			if !field.IsExported() || !readerType.AssignableTo(field.Type) {
+				part.Close()
+				return fmt.Errorf("httppayload: field %q must be an exported io.Reader to bind multipart part %q", field.Name, name)
+			}
+		}
+
+		reader, err := boundPart(part, name, field)
+		if err != nil {
+			part.Close()
+			return err
+		}
+
+		var buf *bytes.Buffer
+		if hasField {
+			buf = &bytes.Buffer{}
+			if s.handler != nil {
+				reader = io.TeeReader(reader, buf)
+			}
+		}
+
+		if s.handler != nil {
+			header := &multipart.FileHeader{Filename: part.FileName(), Header: part.Header}
+			if err := s.handler(name, header, reader); err != nil {
+				part.Close()
+				return err
+			}
+		} else if hasField {
+			if _, err := io.Copy(buf, reader); err != nil {
+				part.Close()
+				return err
+			}
+		}
+
+		if hasField {
+			rv.Field(index).Set(reflect.ValueOf(io.Reader(buf)))
+		}
+
+		part.Close()
+	}
+}
+
+func boundPart(part *multipart.Part, name string, field reflect.StructField) (io.Reader, error) {
+	var reader io.Reader = part
+
+	if maxTag, ok := field.Tag.Lookup("multipart-max"); ok {
+		max, err := parseSize(maxTag)
+		if err != nil {
+			return nil, fmt.Errorf("httppayload: part %q: %w", name, err)
+		}
+		reader = &limitedPartReader{r: reader, field: name, max: max}
+	}
+
+	if acceptTag, ok := field.Tag.Lookup("multipart-accept"); ok {
+		accept := strings.Split(acceptTag, ",")
+		for i := range accept {
+			accept[i] = strings.TrimSpace(accept[i])
+		}
+
+		contentType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		if err != nil {
+			contentType = part.Header.Get("Content-Type")
+		}
+
+		if !contains(accept, contentType) {
+			return nil, &MultipartTypeError{Field: name, ContentType: contentType, Accept: accept}
+		}
+	}
+
+	return reader, nil
+}
+
+func contains(list []string, v string) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+// limitedPartReader caps how many bytes can be read from a part, returning a
+// MultipartSizeError instead of silently truncating once the cap is reached.
+type limitedPartReader struct {
+	r     io.Reader
+	field string
+	max   int64
+	read  int64
+}
+
+func (l *limitedPartReader) Read(p []byte) (int, error) {
+	if l.read > l.max {
+		return 0, &MultipartSizeError{Field: l.field, Max: l.max}
+	}
+
+	// Read one byte past the limit so a part of exactly max bytes isn't mistaken for one
+	// that exceeds it.
+	if allowed := l.max + 1 - l.read; int64(len(p)) > allowed {
+		p = p[:allowed]
+	}
+
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.max {
+		return n, &MultipartSizeError{Field: l.field, Max: l.max}
+	}
+	return n, err
+}
+
+func parseSize(s string) (int64, error) {
+	units := []struct {
+		suffix string
+		mult   int64
+	}{
+		{"GB", 1 << 30},
+		{"MB", 1 << 20},
+		{"KB", 1 << 10},
+		{"B", 1},
+	}
+
+	for _, u := range units {
+		if strings.HasSuffix(s, u.suffix) {
+			n, err := strconv.ParseInt(strings.TrimSuffix(s, u.suffix), 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid size %q: %w", s, err)
+			}
+			return n * u.mult, nil
+		}
+	}
+
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func NewStreamingMultipartScanner(r io.Reader, boundary string, handler MultipartPartHandler) *StreamingMultipartScanner {
+	return &StreamingMultipartScanner{
+		r:       multipart.NewReader(r, boundary),
+		handler: handler,
+	}
+}
+
+// NewStreamingMultipartScannerFromRequest builds a `StreamingMultipartScanner` from the
+// request body, reading the boundary out of its `Content-Type` header
+func NewStreamingMultipartScannerFromRequest(req *http.Request, handler MultipartPartHandler) (*StreamingMultipartScanner, error) {
+	_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	boundary, ok := params["boundary"]
+	if !ok {
+		return nil, fmt.Errorf("httppayload: content type is missing a multipart boundary")
+	}
+
+	return NewStreamingMultipartScanner(req.Body, boundary, handler), nil
+}
@@ -0,0 +1,109 @@
+package httppayload_test
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"testing"
+
+	payload "github.com/canpacis/http-payload"
+	"github.com/stretchr/testify/assert"
+)
+
+type StreamingParams struct {
+	Document io.Reader `multipart:"document"`
+}
+
+func writeMultipartFile(t *testing.T, field, filename, contentType string, content []byte) (*bytes.Buffer, string) {
+	t.Helper()
+	assert := assert.New(t)
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	part, err := w.CreatePart(map[string][]string{
+		"Content-Disposition": {`form-data; name="` + field + `"; filename="` + filename + `"`},
+		"Content-Type":        {contentType},
+	})
+	assert.NoError(err)
+	_, err = part.Write(content)
+	assert.NoError(err)
+	assert.NoError(w.Close())
+
+	return body, w.Boundary()
+}
+
+func TestStreamingMultipartScanner(t *testing.T) {
+	assert := assert.New(t)
+	body, boundary := writeMultipartFile(t, "document", "file.txt", "text/plain", []byte("text document"))
+
+	p := &StreamingParams{}
+	err := payload.NewStreamingMultipartScanner(body, boundary, nil).Scan(p)
+	assert.NoError(err)
+
+	content, err := io.ReadAll(p.Document)
+	assert.NoError(err)
+	assert.Equal("text document", string(content))
+}
+
+func TestStreamingMultipartScannerHandler(t *testing.T) {
+	assert := assert.New(t)
+	body, boundary := writeMultipartFile(t, "document", "file.txt", "text/plain", []byte("text document"))
+
+	var gotField string
+	var gotContent []byte
+
+	err := payload.NewStreamingMultipartScanner(body, boundary, func(field string, header *multipart.FileHeader, r io.Reader) error {
+		gotField = field
+		var err error
+		gotContent, err = io.ReadAll(r)
+		return err
+	}).Scan(&StreamingParams{})
+
+	assert.NoError(err)
+	assert.Equal("document", gotField)
+	assert.Equal("text document", string(gotContent))
+}
+
+type StreamingLimitedParams struct {
+	Document io.Reader `multipart:"document" multipart-max:"4B"`
+}
+
+func TestStreamingMultipartScannerSizeLimit(t *testing.T) {
+	assert := assert.New(t)
+	body, boundary := writeMultipartFile(t, "document", "file.txt", "text/plain", []byte("too big"))
+
+	p := &StreamingLimitedParams{}
+	err := payload.NewStreamingMultipartScanner(body, boundary, nil).Scan(p)
+
+	var sizeErr *payload.MultipartSizeError
+	assert.ErrorAs(err, &sizeErr)
+}
+
+func TestStreamingMultipartScannerSizeLimitExact(t *testing.T) {
+	assert := assert.New(t)
+	body, boundary := writeMultipartFile(t, "document", "file.txt", "text/plain", []byte("1234"))
+
+	p := &StreamingLimitedParams{}
+	err := payload.NewStreamingMultipartScanner(body, boundary, nil).Scan(p)
+	assert.NoError(err)
+
+	content, err := io.ReadAll(p.Document)
+	assert.NoError(err)
+	assert.Equal("1234", string(content))
+}
+
+type StreamingAcceptParams struct {
+	Document io.Reader `multipart:"document" multipart-accept:"image/png,image/jpeg"`
+}
+
+func TestStreamingMultipartScannerTypeReject(t *testing.T) {
+	assert := assert.New(t)
+	body, boundary := writeMultipartFile(t, "document", "file.txt", "text/plain", []byte("text document"))
+
+	p := &StreamingAcceptParams{}
+	err := payload.NewStreamingMultipartScanner(body, boundary, nil).Scan(p)
+
+	var typeErr *payload.MultipartTypeError
+	assert.ErrorAs(err, &typeErr)
+}
@@ -0,0 +1,132 @@
+package httppayload
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// A Codec knows how to build a Scanner and a Printer for a single media type. It is the
+// extension point `RegisterCodec` plugs into so alternate encoders (e.g. faster JSON
+// libraries) can replace or extend the built-ins without forking the module.
+type Codec struct {
+	NewScanner func(io.Reader) Scanner
+	NewPrinter func(http.ResponseWriter) Printer
+}
+
+// codecs is keyed by media type. It's a sync.Map rather than a plain map guarded by a mutex
+// since RegisterCodec can race with in-flight negotiated scans/prints reading it.
+var codecs sync.Map
+
+func init() {
+	codecs.Store("application/json", Codec{
+		NewScanner: func(r io.Reader) Scanner { return NewJSONScanner(r) },
+		NewPrinter: func(w http.ResponseWriter) Printer { return NewJSONPrinter(w) },
+	})
+	codecs.Store("application/xml", Codec{
+		NewScanner: func(r io.Reader) Scanner { return NewXMLScanner(r) },
+		NewPrinter: func(w http.ResponseWriter) Printer { return NewXMLPrinter(w) },
+	})
+	codecs.Store("application/x-protobuf", Codec{
+		NewScanner: func(r io.Reader) Scanner { return NewProtoScanner(r) },
+		NewPrinter: func(w http.ResponseWriter) Printer { return NewProtoPrinter(w) },
+	})
+}
+
+// DefaultMediaType is used by the negotiating scanner and printer when a request carries
+// no `Content-Type`/`Accept` header, or when none of the offered media types are registered.
+const DefaultMediaType = "application/json"
+
+// RegisterCodec registers or replaces the codec used for mediaType, letting users swap in
+// alternate encoders without forking the module.
+func RegisterCodec(mediaType string, codec Codec) {
+	codecs.Store(mediaType, codec)
+}
+
+func codecFor(mediaType string) (Codec, bool) {
+	v, ok := codecs.Load(mediaType)
+	if !ok {
+		return Codec{}, false
+	}
+	return v.(Codec), true
+}
+
+// A scanner that picks the registered codec matching the request's `Content-Type` header
+// and scans the body with it. Falls back to `DefaultMediaType` when the header is absent
+// or can't be parsed; a media type with no registered codec is returned as an error.
+type NegotiateScanner struct {
+	r           io.Reader
+	contentType string
+}
+
+// Scans the body onto v using the codec matching the scanner's content type
+func (s *NegotiateScanner) Scan(v any) error {
+	mediaType := DefaultMediaType
+	if s.contentType != "" {
+		if parsed, _, err := mime.ParseMediaType(s.contentType); err == nil {
+			mediaType = parsed
+		}
+	}
+
+	codec, ok := codecFor(mediaType)
+	if !ok {
+		return fmt.Errorf("httppayload: no codec registered for content type %q", mediaType)
+	}
+
+	return codec.NewScanner(s.r).Scan(v)
+}
+
+func NewNegotiateScanner(r io.Reader, contentType string) *NegotiateScanner {
+	return &NegotiateScanner{r: r, contentType: contentType}
+}
+
+// NewNegotiateScannerFromRequest builds a `NegotiateScanner` from the request's body and
+// `Content-Type` header
+func NewNegotiateScannerFromRequest(r *http.Request) *NegotiateScanner {
+	return NewNegotiateScanner(r.Body, r.Header.Get("Content-Type"))
+}
+
+// A printer that picks the registered codec matching the request's `Accept` header and
+// prints the value with it. Falls back to `DefaultMediaType` when the header is absent or
+// none of the offered media types are registered.
+type NegotiatePrinter struct {
+	w      http.ResponseWriter
+	accept string
+}
+
+// Prints v onto the response writer using the codec matching the printer's accept header
+func (p *NegotiatePrinter) Print(v any) error {
+	mediaType := DefaultMediaType
+
+	for _, offered := range strings.Split(p.accept, ",") {
+		offered = strings.TrimSpace(strings.SplitN(offered, ";", 2)[0])
+		if offered == "" {
+			continue
+		}
+		if _, ok := codecFor(offered); ok {
+			mediaType = offered
+			break
+		}
+	}
+
+	codec, ok := codecFor(mediaType)
+	if !ok {
+		return fmt.Errorf("httppayload: no codec registered for content type %q", mediaType)
+	}
+
+	p.w.Header().Set("Content-Type", mediaType)
+	return codec.NewPrinter(p.w).Print(v)
+}
+
+func NewNegotiatePrinter(w http.ResponseWriter, accept string) *NegotiatePrinter {
+	return &NegotiatePrinter{w: w, accept: accept}
+}
+
+// NewNegotiatePrinterFromRequest builds a `NegotiatePrinter` from the response writer and
+// the request's `Accept` header
+func NewNegotiatePrinterFromRequest(w http.ResponseWriter, r *http.Request) *NegotiatePrinter {
+	return NewNegotiatePrinter(w, r.Header.Get("Accept"))
+}
@@ -0,0 +1,55 @@
+package httppayload_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	payload "github.com/canpacis/http-payload"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateScanner(t *testing.T) {
+	assert := assert.New(t)
+
+	body := bytes.NewBuffer([]byte(`{ "email": "test@example.com" }`))
+	p := &Params{}
+
+	err := payload.NewNegotiateScanner(body, "application/json; charset=utf-8").Scan(p)
+	assert.NoError(err)
+	assert.Equal("test@example.com", p.Email)
+
+	body = bytes.NewBuffer([]byte(`<XMLParams><email>test@example.com</email></XMLParams>`))
+	xp := &XMLParams{}
+
+	err = payload.NewNegotiateScanner(body, "application/xml").Scan(xp)
+	assert.NoError(err)
+	assert.Equal("test@example.com", xp.Email)
+}
+
+func TestNegotiatePrinter(t *testing.T) {
+	assert := assert.New(t)
+
+	w := NewResponseWriter()
+	err := payload.NewNegotiatePrinter(w, "application/xml, application/json").Print(&XMLParams{Email: "test@example.com"})
+	assert.NoError(err)
+	assert.Equal("application/xml", w.header.Get("Content-Type"))
+	assert.Equal("<XMLParams><email>test@example.com</email><name></name></XMLParams>", w.buffer.String())
+}
+
+func TestRegisterCodec(t *testing.T) {
+	assert := assert.New(t)
+
+	payload.RegisterCodec("application/vnd.test+json", payload.Codec{
+		NewScanner: func(r io.Reader) payload.Scanner { return payload.NewJSONScanner(r) },
+		NewPrinter: func(w http.ResponseWriter) payload.Printer { return payload.NewJSONPrinter(w) },
+	})
+
+	body := bytes.NewBuffer([]byte(`{ "email": "test@example.com" }`))
+	p := &Params{}
+
+	err := payload.NewNegotiateScanner(body, "application/vnd.test+json").Scan(p)
+	assert.NoError(err)
+	assert.Equal("test@example.com", p.Email)
+}
@@ -0,0 +1,60 @@
+package httppayload
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// A scanner to scan a protobuf message from an `io.Reader` to a struct
+type ProtoScanner struct {
+	r io.Reader
+}
+
+// Scans the protobuf message onto v, v must implement `proto.Message`
+func (s *ProtoScanner) Scan(v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("httppayload: %T does not implement proto.Message", v)
+	}
+
+	b, err := io.ReadAll(s.r)
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(b, msg)
+}
+
+func NewProtoScanner(r io.Reader) *ProtoScanner {
+	return &ProtoScanner{
+		r: r,
+	}
+}
+
+// A printer to print a protobuf message from a struct to an `http.ResponseWriter`
+type ProtoPrinter struct {
+	w http.ResponseWriter
+}
+
+// Prints v onto the response writer, v must implement `proto.Message`
+func (p *ProtoPrinter) Print(v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("httppayload: %T does not implement proto.Message", v)
+	}
+
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.w.Write(b)
+	return err
+}
+
+func NewProtoPrinter(w http.ResponseWriter) *ProtoPrinter {
+	return &ProtoPrinter{w: w}
+}
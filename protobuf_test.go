@@ -0,0 +1,22 @@
+package httppayload_test
+
+import (
+	"testing"
+
+	payload "github.com/canpacis/http-payload"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestProtoScannerPrinter(t *testing.T) {
+	assert := assert.New(t)
+
+	w := NewResponseWriter()
+	err := payload.NewProtoPrinter(w).Print(wrapperspb.String("hello"))
+	assert.NoError(err)
+
+	got := &wrapperspb.StringValue{}
+	err = payload.NewProtoScanner(w.buffer).Scan(got)
+	assert.NoError(err)
+	assert.Equal("hello", got.Value)
+}
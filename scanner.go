@@ -50,7 +50,7 @@ func (h *HeaderScanner) Get(key string) any {
 
 // Scans the headers onto v
 func (s *HeaderScanner) Scan(v any) error {
-	return ende.NewDecoder(s, "header").Decode(v)
+	return bindError(ende.NewDecoder(s, "header").Decode(v))
 }
 
 func NewHeaderScanner(h *http.Header) *HeaderScanner {
@@ -74,7 +74,7 @@ func (v QueryScanner) Cast(from any, to reflect.Type) (any, error) {
 
 // Scans the query values onto v
 func (s *QueryScanner) Scan(v any) error {
-	return ende.NewDecoder(s, "query").Decode(v)
+	return bindError(ende.NewDecoder(s, "query").Decode(v))
 }
 
 func NewQueryScanner(v url.Values) *QueryScanner {
@@ -100,7 +100,7 @@ func (v CookieScanner) Get(key string) any {
 
 // Scans the cookie values onto v
 func (s *CookieScanner) Scan(v any) error {
-	return ende.NewDecoder(s, "cookie").Decode(v)
+	return bindError(ende.NewDecoder(s, "cookie").Decode(v))
 }
 
 func NewCookieScanner(cookies []*http.Cookie) *CookieScanner {
@@ -124,7 +124,7 @@ func (v FormScanner) Cast(from any, to reflect.Type) (any, error) {
 
 // Scans the form data onto v
 func (s *FormScanner) Scan(v any) error {
-	return ende.NewDecoder(s, "form").Decode(v)
+	return bindError(ende.NewDecoder(s, "form").Decode(v))
 }
 
 func NewFormScanner(v *url.Values) *FormScanner {
@@ -148,7 +148,7 @@ func (v PathScanner) Cast(from any, to reflect.Type) (any, error) {
 
 // Scans the path parameters onto v
 func (s *PathScanner) Scan(v any) error {
-	return ende.NewDecoder(s, "path").Decode(v)
+	return bindError(ende.NewDecoder(s, "path").Decode(v))
 }
 
 func NewPathScanner(req *http.Request) *PathScanner {
@@ -198,7 +198,7 @@ type MultipartScanner struct {
 
 // Scans the multipart form data onto v
 func (s *MultipartScanner) Scan(v any) error {
-	return ende.NewDecoder(s.v, "multipart").Decode(v)
+	return bindError(ende.NewDecoder(s.v, "multipart").Decode(v))
 }
 
 func NewMultipartScanner(v *MultipartValues) *MultipartScanner {
@@ -3,6 +3,7 @@ package httppayload_test
 import (
 	"bytes"
 	"crypto/md5"
+	"errors"
 	"fmt"
 	"image"
 	"image/draw"
@@ -123,6 +124,25 @@ func TestQueryScanner(t *testing.T) {
 	c.Run(t)
 }
 
+type RequiredParams struct {
+	Email string `json:"-" query:"email" required:"true"`
+}
+
+func TestQueryScannerRequiredField(t *testing.T) {
+	assert := assert.New(t)
+	p := &RequiredParams{}
+
+	err := payload.NewQueryScanner(url.Values{}).Scan(p)
+	assert.Error(err)
+
+	var ferrs payload.FieldErrors
+	assert.True(errors.As(err, &ferrs))
+	assert.Len(ferrs, 1)
+	assert.Equal("query", ferrs[0].Source)
+	assert.Equal("email", ferrs[0].Key)
+	assert.Equal("Email", ferrs[0].Field)
+}
+
 func TestFormScanner(t *testing.T) {
 	form := &url.Values{}
 	form.Set("filters", "sepia,monochrome")
@@ -0,0 +1,96 @@
+package httppayload
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SourceTags lists the struct tag keys recognised by the built-in scanners, in the order the
+// `validator` package's adapter checks them when mapping a struct field back to its
+// originating source for a `ValidationError`.
+var SourceTags = []string{"json", "query", "form", "header", "cookie", "path", "multipart"}
+
+// Validator runs after a scan completes and reports whether v satisfies whatever rules it
+// implements, e.g. struct tag based validation.
+type Validator interface {
+	Validate(any) error
+}
+
+var (
+	defaultValidatorMu sync.RWMutex
+	defaultValidator   Validator
+)
+
+// SetDefaultValidator sets the validator used by a `ValidatingScanner` that wasn't given one
+// explicitly.
+func SetDefaultValidator(v Validator) {
+	defaultValidatorMu.Lock()
+	defer defaultValidatorMu.Unlock()
+	defaultValidator = v
+}
+
+func getDefaultValidator() Validator {
+	defaultValidatorMu.RLock()
+	defer defaultValidatorMu.RUnlock()
+	return defaultValidator
+}
+
+// ValidationError describes a single validation rule that failed for a bound struct field.
+type ValidationError struct {
+	Source string // the tag key the field was bound from, e.g. "json" or "query"
+	Field  string // the struct field name
+	Err    error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("httppayload: validation failed for %s field %q: %v", e.Source, e.Field, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors aggregates every `ValidationError` a single `Validate` call produced.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+
+	msg := fmt.Sprintf("httppayload: %d fields failed validation:", len(e))
+	for _, ve := range e {
+		msg += "\n  " + ve.Error()
+	}
+	return msg
+}
+
+// ValidatingScanner wraps a Scanner and runs a Validator against the scanned value once the
+// wrapped scanner succeeds, falling back to the default validator set with
+// `SetDefaultValidator` when none is given.
+type ValidatingScanner struct {
+	Scanner
+	Validator Validator
+}
+
+// Scan runs the wrapped scanner, then validates v, short-circuiting on the first of either
+// to fail.
+func (s *ValidatingScanner) Scan(v any) error {
+	if err := s.Scanner.Scan(v); err != nil {
+		return err
+	}
+
+	validator := s.Validator
+	if validator == nil {
+		validator = getDefaultValidator()
+	}
+	if validator == nil {
+		return nil
+	}
+
+	return validator.Validate(v)
+}
+
+func NewValidatingScanner(scanner Scanner, validator Validator) *ValidatingScanner {
+	return &ValidatingScanner{Scanner: scanner, Validator: validator}
+}
@@ -0,0 +1,63 @@
+// Package validator adapts github.com/go-playground/validator/v10 to the
+// payload.Validator interface, so a ValidatingScanner can enforce `validate:"..."`
+// struct tags at bind time.
+package validator
+
+import (
+	"reflect"
+
+	pv "github.com/go-playground/validator/v10"
+
+	payload "github.com/canpacis/http-payload"
+)
+
+// Adapter satisfies `payload.Validator` by running a `go-playground/validator/v10`
+// instance over the scanned value.
+type Adapter struct {
+	validate *pv.Validate
+}
+
+// Validate runs v through the underlying go-playground validator and, on failure, maps
+// each offending field back to the source tag it was bound from.
+func (a *Adapter) Validate(v any) error {
+	err := a.validate.Struct(v)
+	if err == nil {
+		return nil
+	}
+
+	fieldErrs, ok := err.(pv.ValidationErrors)
+	if !ok {
+		return err
+	}
+
+	rt := reflect.TypeOf(v)
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	errs := make(payload.ValidationErrors, 0, len(fieldErrs))
+	for _, fe := range fieldErrs {
+		field, _ := rt.FieldByName(fe.StructField())
+		errs = append(errs, &payload.ValidationError{
+			Source: sourceOf(field),
+			Field:  fe.StructField(),
+			Err:    fe,
+		})
+	}
+
+	return errs
+}
+
+func sourceOf(field reflect.StructField) string {
+	for _, tag := range payload.SourceTags {
+		if key, ok := field.Tag.Lookup(tag); ok && key != "-" {
+			return tag
+		}
+	}
+	return ""
+}
+
+// New builds an Adapter around a fresh go-playground/validator/v10 instance.
+func New() *Adapter {
+	return &Adapter{validate: pv.New()}
+}
@@ -0,0 +1,28 @@
+package validator_test
+
+import (
+	"testing"
+
+	payload "github.com/canpacis/http-payload"
+	"github.com/canpacis/http-payload/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+type Params struct {
+	Email string `query:"email" validate:"required,email"`
+}
+
+func TestAdapterValidate(t *testing.T) {
+	assert := assert.New(t)
+	adapter := validator.New()
+
+	assert.NoError(adapter.Validate(&Params{Email: "test@example.com"}))
+
+	err := adapter.Validate(&Params{Email: "not-an-email"})
+	assert.Error(err)
+
+	var verrs payload.ValidationErrors
+	assert.ErrorAs(err, &verrs)
+	assert.Equal("query", verrs[0].Source)
+	assert.Equal("Email", verrs[0].Field)
+}
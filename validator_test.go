@@ -0,0 +1,47 @@
+package httppayload_test
+
+import (
+	"errors"
+	"testing"
+
+	payload "github.com/canpacis/http-payload"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubValidator struct {
+	err error
+}
+
+func (v stubValidator) Validate(any) error {
+	return v.err
+}
+
+func TestValidatingScanner(t *testing.T) {
+	assert := assert.New(t)
+
+	err := payload.NewValidatingScanner(bytesScanner(), stubValidator{}).Scan(&Params{})
+	assert.NoError(err)
+
+	wantErr := errors.New("email is invalid")
+	err = payload.NewValidatingScanner(bytesScanner(), stubValidator{err: wantErr}).Scan(&Params{})
+	assert.ErrorIs(err, wantErr)
+}
+
+func TestValidatingScannerDefaultValidator(t *testing.T) {
+	assert := assert.New(t)
+	payload.SetDefaultValidator(nil)
+
+	err := payload.NewValidatingScanner(bytesScanner(), nil).Scan(&Params{})
+	assert.NoError(err)
+
+	wantErr := errors.New("invalid")
+	payload.SetDefaultValidator(stubValidator{err: wantErr})
+	defer payload.SetDefaultValidator(nil)
+
+	err = payload.NewValidatingScanner(bytesScanner(), nil).Scan(&Params{})
+	assert.ErrorIs(err, wantErr)
+}
+
+func bytesScanner() payload.Scanner {
+	return payload.NewJSONScannerFromBytes([]byte(`{ "email": "test@example.com" }`))
+}
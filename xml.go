@@ -0,0 +1,43 @@
+package httppayload
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/http"
+)
+
+// A scanner to scan xml value from an `io.Reader` to a struct
+type XMLScanner struct {
+	r io.Reader
+}
+
+// Scans the xml onto v
+func (s *XMLScanner) Scan(v any) error {
+	return xml.NewDecoder(s.r).Decode(v)
+}
+
+func NewXMLScanner(r io.Reader) *XMLScanner {
+	return &XMLScanner{
+		r: r,
+	}
+}
+
+func NewXMLScannerFromBytes(b []byte) *XMLScanner {
+	return &XMLScanner{
+		r: bytes.NewBuffer(b),
+	}
+}
+
+// A printer to print xml value from a struct to an `http.ResponseWriter`
+type XMLPrinter struct {
+	w http.ResponseWriter
+}
+
+func (p *XMLPrinter) Print(v any) error {
+	return xml.NewEncoder(p.w).Encode(v)
+}
+
+func NewXMLPrinter(w http.ResponseWriter) *XMLPrinter {
+	return &XMLPrinter{w: w}
+}
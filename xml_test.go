@@ -0,0 +1,36 @@
+package httppayload_test
+
+import (
+	"bytes"
+	"testing"
+
+	payload "github.com/canpacis/http-payload"
+	"github.com/stretchr/testify/assert"
+)
+
+type XMLParams struct {
+	Email string `xml:"email"`
+	Name  string `xml:"name"`
+}
+
+func TestXMLScanner(t *testing.T) {
+	body := bytes.NewBuffer([]byte(`<XMLParams><email>test@example.com</email><name>John Doe</name></XMLParams>`))
+
+	assert := assert.New(t)
+	p := &XMLParams{}
+
+	err := payload.NewXMLScanner(body).Scan(p)
+	assert.NoError(err)
+	assert.Equal("test@example.com", p.Email)
+	assert.Equal("John Doe", p.Name)
+}
+
+func TestXMLPrinter(t *testing.T) {
+	w := NewResponseWriter()
+
+	err := payload.NewXMLPrinter(w).Print(&XMLParams{Email: "test@example.com", Name: "John Doe"})
+
+	assert := assert.New(t)
+	assert.NoError(err)
+	assert.Equal("<XMLParams><email>test@example.com</email><name>John Doe</name></XMLParams>", w.buffer.String())
+}